@@ -46,6 +46,26 @@ type NodeClaimSpec struct {
 	// NodeClassRef is a reference to an object that defines provider specific configuration
 	// +required
 	NodeClassRef *NodeClassReference `json:"nodeClassRef"`
+	// TerminationGracePeriod is the maximum duration the controller will wait before forcibly deleting the pods on a node, measured from when deletion is first initiated.
+	//
+	// Warning: this feature takes precedence over a Pod's terminationGracePeriodSeconds value, and bypasses any blocked PDBs or the karpenter.sh/do-not-disrupt annotation.
+	//
+	// This field is intended to be used by cluster administrators to enforce that nodes can be cycled within a given time period.
+	// When set, the following sequence is triggered upon NodeClaim deletion:
+	// 1. The NodeClaim's nodes are cordoned and drained as normal, respecting PDBs and do-not-disrupt annotations.
+	// 2. If the grace period elapses, any pods still remaining (including those blocked by a PDB or marked do-not-disrupt) are forcibly deleted.
+	// 3. Once the node is fully drained, or the TerminationGracePeriod has elapsed, the NodeClaim API Provider's Delete() is called.
+	// +kubebuilder:validation:XValidation:rule="self > duration('0s')",message="terminationGracePeriod must be positive"
+	// +optional
+	TerminationGracePeriod *metav1.Duration `json:"terminationGracePeriod,omitempty"`
+	// ExpireAfter is the duration the controller will wait before terminating a node, measured from when the node is
+	// created. This is useful to implement features like eventually consistent node upgrade, memory leak protection,
+	// and disruption testing.
+	// +kubebuilder:validation:Pattern=`^(([0-9]+(s|m|h))+)|(Never)$`
+	// +kubebuilder:validation:Type="string"
+	// +kubebuilder:default:="720h"
+	// +optional
+	ExpireAfter NillableDuration `json:"expireAfter,omitempty"`
 }
 
 // A node selector requirement with min values is a selector that contains values, a key, an operator that relates the key and values
@@ -68,6 +88,15 @@ type ResourceRequirements struct {
 	Requests v1.ResourceList `json:"requests,omitempty"`
 }
 
+// ConditionTypeExpired is set on a NodeClaim's status once its ExpireAfter duration, measured from creation, has
+// elapsed. The expiration controller owns this condition.
+const ConditionTypeExpired = "Expired"
+
+// TerminationTimestampAnnotationKey is set on the NodeClaim's underlying Node by the termination controller once a
+// deletion deadline, computed from the NodeClaim's TerminationGracePeriod, has been established. Disruption and
+// scheduling code can use this annotation to treat the node as terminating-with-no-holds once it's past.
+const TerminationTimestampAnnotationKey = "karpenter.sh/nodeclaim-termination-timestamp"
+
 type NodeClassReference struct {
 	// Kind of the referent; More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds"
 	// +required
@@ -100,7 +129,9 @@ type NodeClaim struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.requirements == oldSelf.requirements",message="requirements is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.resources == oldSelf.resources",message="resources is immutable"
+	// +kubebuilder:validation:XValidation:rule="self.nodeClassRef == oldSelf.nodeClassRef",message="nodeClassRef is immutable"
 	// +required
 	Spec   NodeClaimSpec   `json:"spec"`
 	Status NodeClaimStatus `json:"status,omitempty"`
@@ -0,0 +1,22 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// Hub marks NodeClaim as the conversion hub so that the v1beta1 API version can implement ConvertTo/ConvertFrom
+// against it. v1beta1.NodeClaimSpec only carries the taints and startupTaints fields, so a v1beta1 client can read
+// a NodeClaim's taints but can't create or update one; v1beta1 is read-only.
+func (*NodeClaim) Hub() {}
@@ -0,0 +1,64 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeClaimStatus defines the observed state of the NodeClaim
+type NodeClaimStatus struct {
+	// NodeName is the name of the corresponding node if it exists
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+	// ProviderID of the corresponding node object
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+	// Conditions contains signals for health and readiness
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// StatusConditions returns a handle for getting and setting the NodeClaim's conditions by type.
+func (in *NodeClaim) StatusConditions() ConditionSet {
+	return ConditionSet{conditions: &in.Status.Conditions}
+}
+
+// ConditionSet is a minimal helper for flipping well-known condition types true on a NodeClaim's status.
+type ConditionSet struct {
+	conditions *[]metav1.Condition
+}
+
+// SetTrue marks the given condition type as True, updating its LastTransitionTime if the status actually changed.
+func (c ConditionSet) SetTrue(conditionType string) {
+	now := metav1.Now()
+	for i := range *c.conditions {
+		if (*c.conditions)[i].Type == conditionType {
+			if (*c.conditions)[i].Status != metav1.ConditionTrue {
+				(*c.conditions)[i].Status = metav1.ConditionTrue
+				(*c.conditions)[i].LastTransitionTime = now
+			}
+			return
+		}
+	}
+	*c.conditions = append(*c.conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		LastTransitionTime: now,
+		Reason:             conditionType,
+	})
+}
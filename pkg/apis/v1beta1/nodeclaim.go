@@ -0,0 +1,77 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1beta1 is the deprecated, pre-GA NodeClaim API version. v1 is now the storage version; v1beta1 is kept
+// around only as a conversion-webhook-served read-only surface for clients that haven't migrated yet. It never
+// gained Requirements, Resources or NodeClassRef, so it has no way to carry everything a v1 NodeClaim requires —
+// writes through v1beta1 are rejected rather than silently producing an incomplete v1 NodeClaim.
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeClaimSpec describes the desired state of the NodeClaim.
+//
+// Deprecated: use the v1 NodeClaimSpec. This type is served only through the conversion webhook.
+type NodeClaimSpec struct {
+	// Taints will be applied to the NodeClaim's node.
+	// +optional
+	Taints []v1.Taint `json:"taints,omitempty"`
+	// StartupTaints are taints that are applied to nodes upon startup which are expected to be removed automatically
+	// within a short period of time, typically by a DaemonSet that tolerates the taint.
+	// +optional
+	StartupTaints []v1.Taint `json:"startupTaints,omitempty"`
+}
+
+// NodeClaimStatus defines the observed state of the NodeClaim.
+//
+// Deprecated: use the v1 NodeClaimStatus. This type is served only through the conversion webhook.
+type NodeClaimStatus struct {
+	// NodeName is the name of the corresponding node if it exists
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+	// ProviderID of the corresponding node object
+	// +optional
+	ProviderID string `json:"providerID,omitempty"`
+	// Conditions contains the NodeClaim's current condition set.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// NodeClaim is the Schema for the v1beta1 NodeClaims API.
+//
+// Deprecated: use the v1 NodeClaim. This type is served only through the conversion webhook.
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+type NodeClaim struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeClaimSpec   `json:"spec"`
+	Status NodeClaimStatus `json:"status,omitempty"`
+}
+
+// NodeClaimList contains a list of v1beta1 NodeClaims.
+//
+// Deprecated: use the v1 NodeClaimList.
+// +kubebuilder:object:root=true
+type NodeClaimList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeClaim `json:"items"`
+}
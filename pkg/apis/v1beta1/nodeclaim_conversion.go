@@ -0,0 +1,46 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	v1 "sigs.k8s.io/karpenter/pkg/apis/v1"
+)
+
+// ConvertTo would convert this v1beta1 NodeClaim to the v1 hub version, but v1beta1.NodeClaimSpec has no field for
+// v1's required Requirements/Resources/NodeClassRef (or for ExpireAfter/TerminationGracePeriod, which only ever
+// existed on v1). There's no value to convert those from, so rather than writing a v1 NodeClaim silently missing
+// its required fields, creates and updates through v1beta1 are rejected; v1beta1 is read-only.
+func (src *NodeClaim) ConvertTo(_ conversion.Hub) error {
+	return fmt.Errorf("nodeclaim %q: v1beta1 is deprecated and read-only; create or update through v1 instead", src.Name)
+}
+
+// ConvertFrom converts from the v1 hub version into this v1beta1 NodeClaim.
+func (dst *NodeClaim) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1.NodeClaim)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Spec.Taints = src.Spec.Taints
+	dst.Spec.StartupTaints = src.Spec.StartupTaints
+	dst.Status.NodeName = src.Status.NodeName
+	dst.Status.ProviderID = src.Status.ProviderID
+	dst.Status.Conditions = src.Status.Conditions
+	return nil
+}
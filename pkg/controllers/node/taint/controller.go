@@ -0,0 +1,157 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package taint reconciles taint edits made to a NodeClaim's spec onto its underlying Node, now that taints,
+// startupTaints, expireAfter and terminationGracePeriod are mutable fields. It's driven by state.NodeClaimController,
+// which enqueues a pass here whenever it observes a NodeClaim update, rather than watching NodeClaims itself.
+package taint
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	v1 "sigs.k8s.io/karpenter/pkg/apis/v1"
+	"sigs.k8s.io/karpenter/pkg/operator/injection"
+)
+
+// appliedTaintsAnnotationKey records the taint keys this controller applied on a previous reconcile, so that a key
+// later removed from spec.taints/spec.startupTaints can be distinguished from a key some other actor owns.
+const appliedTaintsAnnotationKey = "karpenter.sh/applied-taints"
+
+// triggerChannelBuffer bounds how many pending taint-reconcile requests can queue up before Enqueue starts
+// dropping them; a dropped enqueue just delays convergence until the NodeClaim's next update.
+const triggerChannelBuffer = 1024
+
+// Controller reconciles the taint delta between a NodeClaim's spec and its underlying Node: taints present in
+// spec.taints/spec.startupTaints but missing from the Node are added, and taints that karpenter previously applied
+// but which have since been removed from spec are removed. Taints outside karpenter's domain (anything not tracked
+// in the NodeClaim's spec) are never touched.
+type Controller struct {
+	kubeClient client.Client
+	trigger    chan event.GenericEvent
+}
+
+func NewController(kubeClient client.Client) *Controller {
+	return &Controller{kubeClient: kubeClient, trigger: make(chan event.GenericEvent, triggerChannelBuffer)}
+}
+
+// Enqueue schedules a taint-reconcile pass for the named NodeClaim's owning Node. Called by
+// state.NodeClaimController whenever it observes a NodeClaim update.
+func (c *Controller) Enqueue(name string) {
+	select {
+	case c.trigger <- event.GenericEvent{Object: &v1.NodeClaim{ObjectMeta: metav1.ObjectMeta{Name: name}}}:
+	default:
+	}
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).WithName("node.taint").WithValues("nodeclaim", req.Name))
+	ctx = injection.WithControllerName(ctx, "node.taint")
+
+	nodeClaim := &v1.NodeClaim{}
+	if err := c.kubeClient.Get(ctx, req.NamespacedName, nodeClaim); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if !nodeClaim.DeletionTimestamp.IsZero() || nodeClaim.Status.NodeName == "" {
+		return reconcile.Result{}, nil
+	}
+
+	node := &corev1.Node{}
+	if err := c.kubeClient.Get(ctx, client.ObjectKey{Name: nodeClaim.Status.NodeName}, node); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+
+	wantedByKey := map[string]corev1.Taint{}
+	for _, t := range append(append([]corev1.Taint{}, nodeClaim.Spec.Taints...), nodeClaim.Spec.StartupTaints...) {
+		wantedByKey[t.Key] = t
+	}
+	previouslyApplied := map[string]bool{}
+	for _, key := range strings.Split(node.Annotations[appliedTaintsAnnotationKey], ",") {
+		if key != "" {
+			previouslyApplied[key] = true
+		}
+	}
+
+	reconciled := make([]corev1.Taint, 0, len(node.Spec.Taints))
+	changed := false
+	seen := map[string]bool{}
+	for _, t := range node.Spec.Taints {
+		if want, ok := wantedByKey[t.Key]; ok {
+			seen[t.Key] = true
+			if want.Value == t.Value && want.Effect == t.Effect {
+				// already matches on the fields we manage; keep the node's taint as-is (e.g. its TimeAdded) rather
+				// than patching for no reason.
+				reconciled = append(reconciled, t)
+			} else {
+				reconciled = append(reconciled, want)
+				changed = true
+			}
+			continue
+		}
+		if previouslyApplied[t.Key] {
+			// karpenter applied this taint before and it's been removed from spec: drop it.
+			changed = true
+			continue
+		}
+		reconciled = append(reconciled, t)
+	}
+	appliedKeys := make([]string, 0, len(wantedByKey))
+	for key, want := range wantedByKey {
+		appliedKeys = append(appliedKeys, key)
+		if !seen[key] {
+			reconciled = append(reconciled, want)
+			changed = true
+		}
+		if !previouslyApplied[key] {
+			// not yet recorded in the annotation; record it so a later removal from spec is recognised
+			changed = true
+		}
+	}
+	if !changed {
+		return reconcile.Result{}, nil
+	}
+
+	stored := node.DeepCopy()
+	node.Spec.Taints = reconciled
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[appliedTaintsAnnotationKey] = strings.Join(appliedKeys, ",")
+	if err := c.kubeClient.Patch(ctx, node, client.MergeFrom(stored)); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	return reconcile.Result{}, nil
+}
+
+func (c *Controller) Register(_ context.Context, m manager.Manager) error {
+	return controllerruntime.NewControllerManagedBy(m).
+		Named("node.taint").
+		WatchesRawSource(source.Channel(c.trigger, &handler.EnqueueRequestForObject{})).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 10}).
+		Complete(c)
+}
@@ -0,0 +1,156 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package termination
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "sigs.k8s.io/karpenter/pkg/apis/v1"
+	"sigs.k8s.io/karpenter/pkg/cloudprovider"
+	"sigs.k8s.io/karpenter/pkg/controllers/node/termination/terminator"
+	"sigs.k8s.io/karpenter/pkg/controllers/state"
+	"sigs.k8s.io/karpenter/pkg/operator/injection"
+)
+
+// drainRequeuePeriod is how often we poll for drain progress while pods are still evicting off the node.
+const drainRequeuePeriod = 5 * time.Second
+
+// Controller drains and deletes NodeClaims that are marked for deletion, forcing the drain once the NodeClaim's
+// TerminationGracePeriod has elapsed so that a stuck eviction (a blocking PDB or a do-not-disrupt pod) can't hold a
+// node indefinitely.
+type Controller struct {
+	kubeClient    client.Client
+	cloudProvider cloudprovider.CloudProvider
+	cluster       *state.Cluster
+	terminator    *terminator.Terminator
+}
+
+func NewController(kubeClient client.Client, cloudProvider cloudprovider.CloudProvider, cluster *state.Cluster) *Controller {
+	return &Controller{
+		kubeClient:    kubeClient,
+		cloudProvider: cloudProvider,
+		cluster:       cluster,
+		terminator:    terminator.NewTerminator(kubeClient),
+	}
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).WithName("node.termination").WithValues("nodeclaim", req.Name))
+	ctx = injection.WithControllerName(ctx, "node.termination")
+
+	nodeClaim := &v1.NodeClaim{}
+	if err := c.kubeClient.Get(ctx, req.NamespacedName, nodeClaim); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if nodeClaim.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	if nodeClaim.Status.NodeName == "" {
+		// never launched a node (or it's already gone from status): nothing to drain, so delete outright.
+		return reconcile.Result{}, c.deleteNodeClaim(ctx, nodeClaim)
+	}
+
+	node := &corev1.Node{}
+	if err := c.kubeClient.Get(ctx, client.ObjectKey{Name: nodeClaim.Status.NodeName}, node); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, c.deleteNodeClaim(ctx, nodeClaim)
+		}
+		return reconcile.Result{}, err
+	}
+
+	deadline, hasDeadline := c.terminator.Deadline(nodeClaim)
+	if hasDeadline {
+		if err := c.ensureDeadlineAnnotation(ctx, node, deadline); err != nil {
+			return reconcile.Result{}, err
+		}
+		c.cluster.MarkNodeClaimTerminating(nodeClaim.Status.ProviderID, deadline)
+	}
+
+	if !node.Spec.Unschedulable {
+		// cordon the node so evicted pods aren't scheduled straight back onto it
+		stored := node.DeepCopy()
+		node.Spec.Unschedulable = true
+		if err := c.kubeClient.Patch(ctx, node, client.MergeFrom(stored)); err != nil {
+			return reconcile.Result{}, client.IgnoreNotFound(err)
+		}
+	}
+
+	done, err := c.terminator.Drain(ctx, node, deadline, hasDeadline)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if !done {
+		// pods are still evicting (or blocked pre-deadline); keep polling rather than deleting the node out from
+		// under them, and requeue at the deadline itself so a forced drain starts promptly once it passes.
+		if hasDeadline {
+			if untilDeadline := time.Until(deadline); untilDeadline > 0 && untilDeadline < drainRequeuePeriod {
+				return reconcile.Result{RequeueAfter: untilDeadline}, nil
+			}
+		}
+		return reconcile.Result{RequeueAfter: drainRequeuePeriod}, nil
+	}
+	if err := c.deleteNodeClaim(ctx, nodeClaim); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
+
+func (c *Controller) ensureDeadlineAnnotation(ctx context.Context, node *corev1.Node, deadline time.Time) error {
+	if node.Annotations[v1.TerminationTimestampAnnotationKey] != "" {
+		return nil
+	}
+	stored := node.DeepCopy()
+	if node.Annotations == nil {
+		node.Annotations = map[string]string{}
+	}
+	node.Annotations[v1.TerminationTimestampAnnotationKey] = deadline.UTC().Format(time.RFC3339)
+	return c.kubeClient.Patch(ctx, node, client.MergeFrom(stored))
+}
+
+func (c *Controller) deleteNodeClaim(ctx context.Context, nodeClaim *v1.NodeClaim) error {
+	if err := c.cloudProvider.Delete(ctx, nodeClaim); err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return client.IgnoreNotFound(c.kubeClient.Delete(ctx, nodeClaim))
+}
+
+func (c *Controller) Register(ctx context.Context, m manager.Manager) error {
+	if err := m.GetFieldIndexer().IndexField(ctx, &corev1.Pod{}, terminator.PodNodeNameIndexKey, func(o client.Object) []string {
+		if nodeName := o.(*corev1.Pod).Spec.NodeName; nodeName != "" {
+			return []string{nodeName}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return controllerruntime.NewControllerManagedBy(m).
+		Named("node.termination").
+		For(&v1.NodeClaim{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 10}).
+		Complete(c)
+}
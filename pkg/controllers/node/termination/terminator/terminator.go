@@ -0,0 +1,116 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminator
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1 "sigs.k8s.io/karpenter/pkg/apis/v1"
+)
+
+// Terminator drains pods from a node, forcibly removing any that are still blocking deletion once the node's
+// termination deadline has elapsed.
+type Terminator struct {
+	kubeClient client.Client
+}
+
+func NewTerminator(kubeClient client.Client) *Terminator {
+	return &Terminator{kubeClient: kubeClient}
+}
+
+// Deadline returns the time by which all pods on the node must be gone, derived from the NodeClaim's
+// TerminationGracePeriod and its deletionTimestamp. A nil TerminationGracePeriod means there's
+// no forced deadline and draining should continue to respect PDBs and do-not-disrupt indefinitely.
+func (t *Terminator) Deadline(nodeClaim *v1.NodeClaim) (time.Time, bool) {
+	if nodeClaim.Spec.TerminationGracePeriod == nil || nodeClaim.DeletionTimestamp == nil {
+		return time.Time{}, false
+	}
+	return nodeClaim.DeletionTimestamp.Add(nodeClaim.Spec.TerminationGracePeriod.Duration), true
+}
+
+// doNotDisruptAnnotationKey blocks normal eviction of a pod, the same way it blocks other karpenter-initiated
+// disruption, until a TerminationGracePeriod deadline forces the issue.
+const doNotDisruptAnnotationKey = "karpenter.sh/do-not-disrupt"
+
+// PodNodeNameIndexKey is the field index Drain lists pods by. The controller that registers this Terminator must
+// index Pod on this field via its manager's field indexer before Drain is ever called.
+const PodNodeNameIndexKey = "spec.nodeName"
+
+// Drain lists the evictable pods on the node and evicts them, respecting PDBs and the karpenter.sh/do-not-disrupt
+// annotation until the deadline has passed, after which remaining pods are deleted directly to force progress.
+// Before the deadline it reports done=true only once every evictable pod is actually gone from the node, so the
+// caller can keep requeuing rather than tearing down the node out from under pods that are still terminating; past
+// the deadline, pods it force-deletes count as gone, so done=true on the pass that deletes them.
+func (t *Terminator) Drain(ctx context.Context, node *corev1.Node, deadline time.Time, hasDeadline bool) (done bool, err error) {
+	pods := &corev1.PodList{}
+	if err := t.kubeClient.List(ctx, pods, client.MatchingFields{PodNodeNameIndexKey: node.Name}); err != nil {
+		return false, err
+	}
+	forced := hasDeadline && time.Now().After(deadline)
+	remaining := 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if isDaemonSetPod(pod) {
+			continue
+		}
+		remaining++
+		if pod.DeletionTimestamp != nil && !forced {
+			// already evicted/deleted and draining out; nothing more to do until it's gone or the deadline passes
+			continue
+		}
+		if forced {
+			if err := t.kubeClient.Delete(ctx, pod, client.GracePeriodSeconds(0)); err != nil && !errors.IsNotFound(err) {
+				return false, err
+			}
+			// force-deleted with a zero grace period: no longer holds up the drain
+			remaining--
+			continue
+		}
+		if pod.Annotations[doNotDisruptAnnotationKey] == "true" {
+			// blocked until the deadline forces it; leave it running
+			continue
+		}
+		if err := t.evict(ctx, pod); err != nil && !errors.IsNotFound(err) {
+			// most commonly a PDB violation (429 TooManyRequests): leave the pod alone and retry on the next
+			// reconcile, or force it once the deadline passes.
+			continue
+		}
+	}
+	return remaining == 0, nil
+}
+
+func (t *Terminator) evict(ctx context.Context, pod *corev1.Pod) error {
+	return t.kubeClient.SubResource("eviction").Create(ctx, pod, &policyv1.Eviction{
+		ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+	})
+}
+
+func isDaemonSetPod(pod *corev1.Pod) bool {
+	for _, ref := range pod.OwnerReferences {
+		if ref.Kind == "DaemonSet" {
+			return true
+		}
+	}
+	return false
+}
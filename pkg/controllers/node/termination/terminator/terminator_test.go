@@ -0,0 +1,176 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package terminator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func newNode() *corev1.Node {
+	return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+}
+
+func newPod(name string, annotations map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Annotations: annotations},
+		Spec:       corev1.PodSpec{NodeName: "node"},
+	}
+}
+
+func newClientBuilder(objs ...client.Object) *fake.ClientBuilder {
+	return fake.NewClientBuilder().
+		WithObjects(objs...).
+		WithIndex(&corev1.Pod{}, PodNodeNameIndexKey, func(o client.Object) []string {
+			return []string{o.(*corev1.Pod).Spec.NodeName}
+		})
+}
+
+// TestDrain_EvictsBeforeDeadline covers the normal path: a pod with no PDB and no do-not-disrupt annotation is
+// evicted immediately, and Drain reports done once the pod has actually gone.
+func TestDrain_EvictsBeforeDeadline(t *testing.T) {
+	pod := newPod("normal", nil)
+	var evicted bool
+	fakeClient := newClientBuilder(newNode(), pod).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+				if subResourceName != "eviction" {
+					return nil
+				}
+				evicted = true
+				return c.Delete(ctx, obj)
+			},
+		}).Build()
+
+	term := NewTerminator(fakeClient)
+	done, err := term.Drain(context.Background(), newNode(), time.Time{}, false)
+	if err != nil {
+		t.Fatalf("Drain returned error: %v", err)
+	}
+	if !evicted {
+		t.Fatalf("expected pod to be evicted")
+	}
+	if done {
+		t.Fatalf("expected done=false on the pass that issues the eviction")
+	}
+
+	done, err = term.Drain(context.Background(), newNode(), time.Time{}, false)
+	if err != nil {
+		t.Fatalf("Drain returned error: %v", err)
+	}
+	if !done {
+		t.Fatalf("expected done=true once the evicted pod is gone")
+	}
+}
+
+// TestDrain_PDBBlockedThenForced covers a pod whose eviction is repeatedly rejected (e.g. by a blocking PDB): it
+// must survive every pre-deadline pass and only be removed once the deadline has elapsed.
+func TestDrain_PDBBlockedThenForced(t *testing.T) {
+	pod := newPod("blocked", nil)
+	fakeClient := newClientBuilder(newNode(), pod).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+				if subResourceName != "eviction" {
+					return nil
+				}
+				return errors.NewTooManyRequests("blocked by PDB", 0)
+			},
+		}).Build()
+
+	term := NewTerminator(fakeClient)
+
+	done, err := term.Drain(context.Background(), newNode(), time.Now().Add(time.Hour), true)
+	if err != nil {
+		t.Fatalf("Drain returned error: %v", err)
+	}
+	if done {
+		t.Fatalf("expected done=false while the PDB keeps blocking eviction")
+	}
+	if err := fakeClient.Get(context.Background(), client.ObjectKeyFromObject(pod), &corev1.Pod{}); err != nil {
+		t.Fatalf("expected blocked pod to still exist before the deadline: %v", err)
+	}
+
+	// past the deadline, the same pod must be force-deleted regardless of the PDB
+	done, err = term.Drain(context.Background(), newNode(), time.Now().Add(-time.Second), true)
+	if err != nil {
+		t.Fatalf("Drain returned error: %v", err)
+	}
+	if !done {
+		t.Fatalf("expected done=true once the blocked pod is force-deleted past the deadline")
+	}
+	if err := fakeClient.Get(context.Background(), client.ObjectKeyFromObject(pod), &corev1.Pod{}); !errors.IsNotFound(err) {
+		t.Fatalf("expected blocked pod to be force-deleted past the deadline, got err=%v", err)
+	}
+}
+
+// TestDrain_DoNotDisruptForcedAfterDeadline covers a pod carrying karpenter.sh/do-not-disrupt: it must never be
+// evicted pre-deadline, and must be force-deleted once the deadline passes.
+func TestDrain_DoNotDisruptForcedAfterDeadline(t *testing.T) {
+	pod := newPod("do-not-disrupt", map[string]string{doNotDisruptAnnotationKey: "true"})
+	var evicted bool
+	fakeClient := newClientBuilder(newNode(), pod).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+				if subResourceName == "eviction" {
+					evicted = true
+				}
+				return nil
+			},
+		}).Build()
+
+	term := NewTerminator(fakeClient)
+	done, err := term.Drain(context.Background(), newNode(), time.Now().Add(time.Hour), true)
+	if err != nil {
+		t.Fatalf("Drain returned error: %v", err)
+	}
+	if done {
+		t.Fatalf("expected done=false before the deadline")
+	}
+	if evicted {
+		t.Fatalf("do-not-disrupt pod must not be evicted before the deadline")
+	}
+
+	done, err = term.Drain(context.Background(), newNode(), time.Now().Add(-time.Second), true)
+	if err != nil {
+		t.Fatalf("Drain returned error: %v", err)
+	}
+	if !done {
+		t.Fatalf("expected done=true once the do-not-disrupt pod is force-deleted past the deadline")
+	}
+	if err := fakeClient.Get(context.Background(), client.ObjectKeyFromObject(pod), &corev1.Pod{}); !errors.IsNotFound(err) {
+		t.Fatalf("expected do-not-disrupt pod to be force-deleted past the deadline, got err=%v", err)
+	}
+}
+
+func TestIsDaemonSetPod(t *testing.T) {
+	pod := newPod("ds", nil)
+	pod.OwnerReferences = []metav1.OwnerReference{{Kind: "DaemonSet", APIVersion: "apps/v1", Name: "ds", UID: "1"}}
+	if !isDaemonSetPod(pod) {
+		t.Fatalf("expected pod owned by a DaemonSet to be recognized as such")
+	}
+	if isDaemonSetPod(newPod("not-ds", nil)) {
+		t.Fatalf("expected a pod with no owner references to not be recognized as a daemonset pod")
+	}
+}
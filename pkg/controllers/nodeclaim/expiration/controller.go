@@ -0,0 +1,89 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package expiration reconciles NodeClaims whose spec.expireAfter has elapsed, marking them Expired and
+// issuing a delete that honors their TerminationGracePeriod.
+package expiration
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1 "sigs.k8s.io/karpenter/pkg/apis/v1"
+	"sigs.k8s.io/karpenter/pkg/controllers/state"
+	"sigs.k8s.io/karpenter/pkg/operator/injection"
+)
+
+// Controller marks NodeClaims as Expired once their spec.expireAfter duration, measured from creation, has
+// elapsed and deletes them.
+type Controller struct {
+	kubeClient client.Client
+	cluster    *state.Cluster
+}
+
+func NewController(kubeClient client.Client, cluster *state.Cluster) *Controller {
+	return &Controller{kubeClient: kubeClient, cluster: cluster}
+}
+
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	ctx = log.IntoContext(ctx, log.FromContext(ctx).WithName("nodeclaim.expiration").WithValues("nodeclaim", req.Name))
+	ctx = injection.WithControllerName(ctx, "nodeclaim.expiration")
+
+	nodeClaim := &v1.NodeClaim{}
+	if err := c.kubeClient.Get(ctx, req.NamespacedName, nodeClaim); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	if !nodeClaim.DeletionTimestamp.IsZero() || nodeClaim.Spec.ExpireAfter.Duration == nil {
+		return reconcile.Result{}, nil
+	}
+
+	expirationTime := nodeClaim.CreationTimestamp.Add(*nodeClaim.Spec.ExpireAfter.Duration)
+	if timeUntilExpiry := time.Until(expirationTime); timeUntilExpiry > 0 {
+		return reconcile.Result{RequeueAfter: timeUntilExpiry}, nil
+	}
+
+	nodePool := nodeClaim.Labels[v1.NodePoolLabelKey]
+	expiredCounter.With(prometheus.Labels{"nodepool": nodePool}).Inc()
+	timeToExpirationSeconds.With(prometheus.Labels{"nodepool": nodePool}).Observe(time.Since(nodeClaim.CreationTimestamp.Time).Seconds())
+
+	stored := nodeClaim.DeepCopy()
+	nodeClaim.StatusConditions().SetTrue(v1.ConditionTypeExpired)
+	if err := c.kubeClient.Status().Patch(ctx, nodeClaim, client.MergeFrom(stored)); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	c.cluster.MarkForExpiration(nodeClaim.Status.ProviderID)
+
+	if err := c.kubeClient.Delete(ctx, nodeClaim); err != nil {
+		return reconcile.Result{}, client.IgnoreNotFound(err)
+	}
+	return reconcile.Result{}, nil
+}
+
+func (c *Controller) Register(_ context.Context, m manager.Manager) error {
+	return controllerruntime.NewControllerManagedBy(m).
+		Named("nodeclaim.expiration").
+		For(&v1.NodeClaim{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 10}).
+		Complete(c)
+}
@@ -0,0 +1,50 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package expiration
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"sigs.k8s.io/karpenter/pkg/metrics"
+)
+
+var (
+	expiredCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "nodeclaims",
+			Name:      "expired_total",
+			Help:      "Number of nodeclaims expired that were evaluated by the expiration controller.",
+		},
+		[]string{"nodepool"},
+	)
+	timeToExpirationSeconds = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: "nodeclaims",
+			Name:      "time_to_expiration_seconds",
+			Help:      "The time between a nodeclaim's creation and when it is marked as expired.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"nodepool"},
+	)
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(expiredCounter, timeToExpirationSeconds)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package state maintains an in-memory snapshot of NodeClaims, keyed by the name the API server uses to identify
+// them, so that scheduling simulation and disruption don't need to hit the API server on every decision.
+package state
+
+import (
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"sigs.k8s.io/karpenter/pkg/apis/v1beta1"
+)
+
+// nodeClaimState is the in-memory record cluster state keeps per NodeClaim.
+type nodeClaimState struct {
+	nodeClaim              *v1beta1.NodeClaim
+	terminatingDeadline    time.Time
+	hasTerminatingDeadline bool
+	markedForExpiration    bool
+}
+
+// Cluster maintains an in-memory view of NodeClaims for scheduling simulation and disruption to consult without
+// round-tripping to the API server.
+type Cluster struct {
+	mu sync.RWMutex
+	// nodeClaimsByName is keyed by NodeClaim name, which is stable for the lifetime of the object, unlike
+	// providerID which isn't assigned until the instance launches.
+	nodeClaimsByName map[string]*nodeClaimState
+	// nameByProviderID lets callers that only have a providerID (e.g. the termination and expiration controllers,
+	// which pass the NodeClaim's status.providerID) look up the owning NodeClaim.
+	nameByProviderID map[string]string
+}
+
+// NewCluster constructs a new cluster state object.
+func NewCluster() *Cluster {
+	return &Cluster{
+		nodeClaimsByName: map[string]*nodeClaimState{},
+		nameByProviderID: map[string]string{},
+	}
+}
+
+// UpdateNodeClaim updates the cluster's in-memory record of the given NodeClaim.
+func (c *Cluster) UpdateNodeClaim(nodeClaim *v1beta1.NodeClaim) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state, ok := c.nodeClaimsByName[nodeClaim.Name]
+	if !ok {
+		state = &nodeClaimState{}
+		c.nodeClaimsByName[nodeClaim.Name] = state
+	}
+	state.nodeClaim = nodeClaim.DeepCopy()
+	if providerID := nodeClaim.Status.ProviderID; providerID != "" {
+		c.nameByProviderID[providerID] = nodeClaim.Name
+	}
+}
+
+// DeleteNodeClaim removes the named NodeClaim from cluster state.
+func (c *Cluster) DeleteNodeClaim(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	state, ok := c.nodeClaimsByName[name]
+	if !ok {
+		return
+	}
+	if state.nodeClaim != nil {
+		delete(c.nameByProviderID, state.nodeClaim.Status.ProviderID)
+	}
+	delete(c.nodeClaimsByName, name)
+}
+
+// MarkNodeClaimTerminating records the deadline, derived from spec.terminationGracePeriod, past which the NodeClaim
+// identified by providerID should be treated as terminating-with-no-holds by scheduling and disruption.
+func (c *Cluster) MarkNodeClaimTerminating(providerID string, deadline time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	name, ok := c.nameByProviderID[providerID]
+	if !ok {
+		return
+	}
+	state, ok := c.nodeClaimsByName[name]
+	if !ok {
+		return
+	}
+	state.terminatingDeadline = deadline
+	state.hasTerminatingDeadline = true
+}
+
+// TerminatingDeadline returns the deadline set by MarkNodeClaimTerminating for the NodeClaim identified by
+// providerID, if any.
+func (c *Cluster) TerminatingDeadline(providerID string) (time.Time, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	name, ok := c.nameByProviderID[providerID]
+	if !ok {
+		return time.Time{}, false
+	}
+	state, ok := c.nodeClaimsByName[name]
+	if !ok || !state.hasTerminatingDeadline {
+		return time.Time{}, false
+	}
+	return state.terminatingDeadline, true
+}
+
+// Taints returns the spec.taints and spec.startupTaints karpenter most recently observed for the NodeClaim
+// identified by providerID, for scheduling simulation to consult instead of re-reading the API server.
+func (c *Cluster) Taints(providerID string) ([]corev1.Taint, []corev1.Taint) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	name, ok := c.nameByProviderID[providerID]
+	if !ok {
+		return nil, nil
+	}
+	state, ok := c.nodeClaimsByName[name]
+	if !ok || state.nodeClaim == nil {
+		return nil, nil
+	}
+	return state.nodeClaim.Spec.Taints, state.nodeClaim.Spec.StartupTaints
+}
+
+// MarkForExpiration records that the NodeClaim identified by providerID has an Expired status condition, so that
+// the disruption/consolidation pipeline can prefer it for replacement.
+func (c *Cluster) MarkForExpiration(providerID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	name, ok := c.nameByProviderID[providerID]
+	if !ok {
+		return
+	}
+	state, ok := c.nodeClaimsByName[name]
+	if !ok {
+		return
+	}
+	state.markedForExpiration = true
+}
+
+// MarkedForExpiration reports whether the NodeClaim identified by providerID has been marked expired via
+// MarkForExpiration.
+func (c *Cluster) MarkedForExpiration(providerID string) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	name, ok := c.nameByProviderID[providerID]
+	if !ok {
+		return false
+	}
+	state, ok := c.nodeClaimsByName[name]
+	if !ok {
+		return false
+	}
+	return state.markedForExpiration
+}
@@ -0,0 +1,90 @@
+/*
+Copyright The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package state
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/karpenter/pkg/apis/v1beta1"
+)
+
+func newNodeClaim(name, providerID string) *v1beta1.NodeClaim {
+	return &v1beta1.NodeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     v1beta1.NodeClaimStatus{ProviderID: providerID},
+	}
+}
+
+func TestCluster_MarkNodeClaimTerminating(t *testing.T) {
+	c := NewCluster()
+	c.UpdateNodeClaim(newNodeClaim("claim-a", "provider-a"))
+
+	if _, ok := c.TerminatingDeadline("provider-a"); ok {
+		t.Fatalf("expected no terminating deadline before it's set")
+	}
+
+	deadline := time.Now().Add(time.Hour)
+	c.MarkNodeClaimTerminating("provider-a", deadline)
+
+	got, ok := c.TerminatingDeadline("provider-a")
+	if !ok {
+		t.Fatalf("expected a terminating deadline to be recorded")
+	}
+	if !got.Equal(deadline) {
+		t.Fatalf("expected deadline %v, got %v", deadline, got)
+	}
+}
+
+func TestCluster_MarkedForExpiration(t *testing.T) {
+	c := NewCluster()
+	c.UpdateNodeClaim(newNodeClaim("claim-b", "provider-b"))
+
+	if c.MarkedForExpiration("provider-b") {
+		t.Fatalf("expected not marked for expiration before MarkForExpiration is called")
+	}
+
+	c.MarkForExpiration("provider-b")
+
+	if !c.MarkedForExpiration("provider-b") {
+		t.Fatalf("expected marked for expiration after MarkForExpiration is called")
+	}
+}
+
+func TestCluster_DeleteNodeClaim(t *testing.T) {
+	c := NewCluster()
+	c.UpdateNodeClaim(newNodeClaim("claim-c", "provider-c"))
+	c.MarkForExpiration("provider-c")
+
+	c.DeleteNodeClaim("claim-c")
+
+	if c.MarkedForExpiration("provider-c") {
+		t.Fatalf("expected state to be gone once the NodeClaim is deleted")
+	}
+}
+
+func TestCluster_UnknownProviderID(t *testing.T) {
+	c := NewCluster()
+	if _, ok := c.TerminatingDeadline("missing"); ok {
+		t.Fatalf("expected no deadline for an unknown provider ID")
+	}
+	if c.MarkedForExpiration("missing") {
+		t.Fatalf("expected false for an unknown provider ID")
+	}
+}
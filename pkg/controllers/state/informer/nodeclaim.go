@@ -18,32 +18,53 @@ package informer
 
 import (
 	"context"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"sigs.k8s.io/karpenter/pkg/operator/injection"
 
+	v1 "sigs.k8s.io/karpenter/pkg/apis/v1"
 	"sigs.k8s.io/karpenter/pkg/apis/v1beta1"
 	"sigs.k8s.io/karpenter/pkg/controllers/state"
 )
 
+// nodeClaimProviderIDIndexKey is the field index used to look up the NodeClaim owning a given provider ID, so that
+// Node events can be mapped back to their owning NodeClaim without a linear scan.
+const nodeClaimProviderIDIndexKey = "status.providerID"
+
+// TaintEnqueuer schedules a taint-reconcile pass for the named NodeClaim's owning Node. Implemented by
+// pkg/controllers/node/taint.Controller.
+type TaintEnqueuer interface {
+	Enqueue(name string)
+}
+
 // NodeClaimController reconciles nodeclaim for the purpose of maintaining state.
 type NodeClaimController struct {
 	kubeClient client.Client
 	cluster    *state.Cluster
+	taints     TaintEnqueuer
 }
 
 // NewNodeClaimController constructs a controller instance
-func NewNodeClaimController(kubeClient client.Client, cluster *state.Cluster) *NodeClaimController {
+func NewNodeClaimController(kubeClient client.Client, cluster *state.Cluster, taints TaintEnqueuer) *NodeClaimController {
 	return &NodeClaimController{
 		kubeClient: kubeClient,
 		cluster:    cluster,
+		taints:     taints,
 	}
 }
 
@@ -61,13 +82,106 @@ func (c *NodeClaimController) Reconcile(ctx context.Context, req reconcile.Reque
 	}
 	c.cluster.UpdateNodeClaim(nodeClaim)
 	// ensure it's aware of any nodes we discover, this is a no-op if the node is already known to our cluster state
-	return reconcile.Result{RequeueAfter: stateRetryPeriod}, nil
+	if !nodeClaim.DeletionTimestamp.IsZero() {
+		if err := c.propagateTerminationDeadline(ctx, nodeClaim); err != nil {
+			return reconcile.Result{}, err
+		}
+	} else if nodeClaim.Status.NodeName != "" && c.taints != nil {
+		// the in-memory taint set is already up to date via UpdateNodeClaim above; trigger the taint controller so
+		// the owning Node's taints converge to it too.
+		c.taints.Enqueue(nodeClaim.Name)
+	}
+	// Node events re-run this reconcile for the owning NodeClaim through the Node watch below, which refreshes the
+	// claim, its termination deadline and its taint reconcile; Node readiness and capacity aren't recorded in cluster
+	// state. The fallback requeue is only needed while the claim is still launching and has no Node to watch yet.
+	if nodeClaim.Status.NodeName == "" {
+		return reconcile.Result{RequeueAfter: stateRetryPeriod}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// propagateTerminationDeadline reads the deadline the termination controller stamped onto the NodeClaim's node
+// (derived from spec.terminationGracePeriod) and forwards it into cluster state, so scheduling and disruption can
+// treat the claim as terminating-with-no-holds once it's past.
+func (c *NodeClaimController) propagateTerminationDeadline(ctx context.Context, nodeClaim *v1beta1.NodeClaim) error {
+	if nodeClaim.Status.NodeName == "" {
+		return nil
+	}
+	node := &corev1.Node{}
+	if err := c.kubeClient.Get(ctx, client.ObjectKey{Name: nodeClaim.Status.NodeName}, node); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	raw, ok := node.Annotations[v1.TerminationTimestampAnnotationKey]
+	if !ok {
+		return nil
+	}
+	deadline, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return nil
+	}
+	c.cluster.MarkNodeClaimTerminating(nodeClaim.Status.ProviderID, deadline)
+	return nil
+}
+
+// nodeToNodeClaim maps a Node to the reconcile.Request for the NodeClaim that owns it, matching on provider ID via
+// the status.providerID field index.
+func (c *NodeClaimController) nodeToNodeClaim(ctx context.Context, o client.Object) []reconcile.Request {
+	node := o.(*corev1.Node)
+	if node.Spec.ProviderID == "" {
+		return nil
+	}
+	nodeClaims := &v1beta1.NodeClaimList{}
+	if err := c.kubeClient.List(ctx, nodeClaims, client.MatchingFields{nodeClaimProviderIDIndexKey: node.Spec.ProviderID}); err != nil {
+		log.FromContext(ctx).Error(err, "failed listing nodeclaims for node watch")
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(nodeClaims.Items))
+	for i := range nodeClaims.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&nodeClaims.Items[i])})
+	}
+	return requests
 }
 
-func (c *NodeClaimController) Register(_ context.Context, m manager.Manager) error {
+// ignoreStatusChurn drops NodeClaim UPDATE events where the only thing that changed is a condition's
+// lastTransitionTime or the object's resourceVersion, both of which flip on every status heartbeat and would
+// otherwise cause needless reconciles across large clusters.
+func ignoreStatusChurn() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldNodeClaim, ok := e.ObjectOld.(*v1beta1.NodeClaim)
+			if !ok {
+				return true
+			}
+			newNodeClaim, ok := e.ObjectNew.(*v1beta1.NodeClaim)
+			if !ok {
+				return true
+			}
+			oldCopy, newCopy := oldNodeClaim.DeepCopy(), newNodeClaim.DeepCopy()
+			oldCopy.ResourceVersion, newCopy.ResourceVersion = "", ""
+			for i := range oldCopy.Status.Conditions {
+				oldCopy.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+			}
+			for i := range newCopy.Status.Conditions {
+				newCopy.Status.Conditions[i].LastTransitionTime = metav1.Time{}
+			}
+			return !equality.Semantic.DeepEqual(oldCopy, newCopy)
+		},
+	}
+}
+
+func (c *NodeClaimController) Register(ctx context.Context, m manager.Manager) error {
+	if err := m.GetFieldIndexer().IndexField(ctx, &v1beta1.NodeClaim{}, nodeClaimProviderIDIndexKey, func(o client.Object) []string {
+		if providerID := o.(*v1beta1.NodeClaim).Status.ProviderID; providerID != "" {
+			return []string{providerID}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
 	return controllerruntime.NewControllerManagedBy(m).
 		Named("state.nodeclaim").
-		For(&v1beta1.NodeClaim{}).
+		For(&v1beta1.NodeClaim{}, builder.WithPredicates(ignoreStatusChurn())).
+		Watches(&corev1.Node{}, handler.EnqueueRequestsFromMapFunc(c.nodeToNodeClaim)).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 10}).
 		Complete(c)
 }